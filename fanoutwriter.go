@@ -4,43 +4,173 @@
 package fanoutwriter
 
 import (
+	"context"
 	"errors"
 	"io"
+	"net"
 	"sync"
+	"time"
 )
 
 var (
-	ErrFellBehind = errors.New("reader fell behind the writers buffer limit")
+	ErrFellBehind                = errors.New("reader fell behind the writers buffer limit")
+	ErrWriteWouldCauseFallBehind = errors.New("write would push a reader past the writers buffer limit")
 )
 
+// DefaultChunkSize is the chunk size used when FanoutWriterConfig.ChunkSize
+// is left unset.
+const DefaultChunkSize = 32 * 1024
+
 // FanoutWriter is a io.WriteCloser which can spawn multiple io.ReadClosers
 // that read at different speeds.
 type FanoutWriter interface {
 	io.WriteCloser
 	NewReader() io.ReadCloser // Returns a new reader which begins reading depending on the configuration
+
+	// Stats returns a point-in-time snapshot of the writer's state.
+	Stats() Stats
+	// Readers returns a point-in-time snapshot of every currently attached
+	// reader's state, without requiring the caller to have kept its own
+	// handle to each one.
+	Readers() []ReaderStats
+}
+
+// ContextReader is implemented by the readers returned from
+// FanoutWriter.NewReader. It allows a single reader's blocking Read to be
+// cancelled via a context.Context, without affecting any other reader or
+// closing the FanoutWriter itself.
+type ContextReader interface {
+	ReadContext(ctx context.Context, p []byte) (int, error)
+}
+
+// StatsReader is implemented by the readers returned from
+// FanoutWriter.NewReader, exposing a snapshot of that individual reader's
+// progress.
+type StatsReader interface {
+	Stats() ReaderStats
+}
+
+// Stats is a point-in-time snapshot of a FanoutWriter's state.
+type Stats struct {
+	BytesWritten  int64 // Total bytes ever added to the buffer by Write or ReadFrom; bytes discarded because no reader was attached are not counted.
+	BytesBuffered int64 // Bytes currently held in the buffer.
+	NumReaders    int   // Number of currently attached readers.
+}
+
+// ReaderStats is a point-in-time snapshot of a single reader's state.
+type ReaderStats struct {
+	BytesRead  int64     // Total bytes ever returned from this reader's Read/ReadContext/WriteTo.
+	Lag        int64     // Bytes the writer is currently ahead of this reader.
+	FellBehind bool      // Whether this reader has fallen behind the Limit and been evicted.
+	CreatedAt  time.Time // When this reader was created.
+}
+
+// chunk is a fixed-capacity segment of the buffer. Chunks are reused through
+// chunkPool once every client has read past them, so the backing array of a
+// long-lived FanoutWriter doesn't grow without bound and old data can be
+// garbage collected before the FanoutWriter itself is closed.
+type chunk struct {
+	buf []byte
+
+	// pinned counts the in-flight WriteTo calls that are reading from buf
+	// without holding fwriter's lock. A pinned chunk is never returned to
+	// chunkPool, since doing so would let a future Write reuse (and mutate)
+	// the backing array out from under the in-flight read.
+	pinned int
+}
+
+var chunkPool = sync.Pool{
+	New: func() interface{} { return new(chunk) },
+}
+
+func getChunk(size int) *chunk {
+	c := chunkPool.Get().(*chunk)
+	c.pinned = 0
+	if cap(c.buf) != size {
+		c.buf = make([]byte, 0, size)
+	} else {
+		c.buf = c.buf[:0]
+	}
+	return c
+}
+
+func putChunk(c *chunk) {
+	chunkPool.Put(c)
+}
+
+// splitChunks slices buf into chunks of at most size bytes each, without
+// copying. It is used to seed the buffer from FanoutWriterConfig.Buf.
+func splitChunks(buf []byte, size int) []*chunk {
+	if len(buf) == 0 {
+		return nil
+	}
+
+	var chunks []*chunk
+	for len(buf) > 0 {
+		n := size
+		if n <= 0 || n > len(buf) {
+			n = len(buf)
+		}
+		chunks = append(chunks, &chunk{buf: buf[:n:n]})
+		buf = buf[n:]
+	}
+	return chunks
 }
 
 type client struct {
-	fw  *fwriter
-	off int
+	fw         *fwriter
+	off        int
+	bytesRead  int64
+	fellBehind bool
+	createdAt  time.Time
 }
 
 type fwriter struct {
 	sync.Mutex
-	buf     []byte
-	update  *sync.Cond
-	c       *FanoutWriterConfig
-	off     int
-	clients map[*client]struct{}
-	closed  bool
+	chunks       []*chunk
+	chunkSize    int
+	update       *sync.Cond
+	writerCond   *sync.Cond
+	c            *FanoutWriterConfig
+	off          int
+	clients      map[*client]struct{}
+	waiters      map[*client]chan struct{}
+	closed       bool
+	bytesWritten int64
 }
 
 type FanoutWriterConfig struct {
-	Buf           []byte // Initial buffer of the writer.
-	Limit         int    // Limit for the size of which buffer may grow
-	ReadFromStart bool   // Whether or not to start a reader from the end or beginning of the buffer.
+	Buf            []byte         // Initial buffer of the writer.
+	Limit          int            // Limit for the size of which buffer may grow
+	ReadFromStart  bool           // Whether or not to start a reader from the end or beginning of the buffer.
+	ChunkSize      int            // Size of each internal buffer chunk. Defaults to DefaultChunkSize.
+	OverflowPolicy OverflowPolicy // What Write does when Limit would otherwise be exceeded. Defaults to ErrorOnFull.
 }
 
+// OverflowPolicy controls what a Write that would push data a reader hasn't
+// read yet past Limit does.
+type OverflowPolicy int
+
+const (
+	// ErrorOnFull causes Write to fail with ErrWriteWouldCauseFallBehind when
+	// a single write alone is larger than Limit, since no amount of evicting
+	// could make room for it without a reader falling behind. A smaller
+	// write that would only push part of an unread reader's data past Limit
+	// still evicts that data, same as DropOldest; the reader discovers this
+	// as ErrFellBehind on its next Read. This is the default.
+	ErrorOnFull OverflowPolicy = iota
+
+	// DropOldest silently discards the oldest buffered data to make room,
+	// same as if Limit had no readers to protect. Readers that lose data
+	// this way discover it as ErrFellBehind on their next Read.
+	DropOldest
+
+	// BlockWriter causes Write to block until the slowest reader has
+	// advanced enough to make room, or the FanoutWriter is closed (in which
+	// case Write returns io.ErrClosedPipe).
+	BlockWriter
+)
+
 // NewDefaultFanoutWriter creates a new FanoutWriter with no initial data and
 // with no buffer limit.
 func NewDefaultFanoutWriter() FanoutWriter {
@@ -53,23 +183,182 @@ func NewDefaultFanoutWriter() FanoutWriter {
 
 // NewFanoutWriter creates a new FanoutWriter with the configuration passed.
 func NewFanoutWriter(c *FanoutWriterConfig) FanoutWriter {
+	chunkSize := c.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	chunks := splitChunks(c.Buf, chunkSize)
+
 	off := 0
 	if !c.ReadFromStart {
 		off = len(c.Buf)
 	}
 
 	f := &fwriter{
-		buf:     c.Buf,
-		c:       c,
-		off:     off,
-		clients: make(map[*client]struct{}),
-		closed:  false,
+		chunks:    chunks,
+		chunkSize: chunkSize,
+		c:         c,
+		off:       off,
+		clients:   make(map[*client]struct{}),
+		waiters:   make(map[*client]chan struct{}),
+		closed:    false,
 	}
 
 	f.update = sync.NewCond(f)
+	f.writerCond = sync.NewCond(f)
 	return f
 }
 
+// bufLen returns the number of bytes currently buffered across all chunks. f
+// must be locked.
+func (f *fwriter) bufLen() int {
+	n := 0
+	for _, c := range f.chunks {
+		n += len(c.buf)
+	}
+	return n
+}
+
+// tail returns the chunk new data should be appended to, allocating a fresh
+// one from chunkPool if the current tail is full (or there isn't one yet). f
+// must be locked.
+func (f *fwriter) tail() *chunk {
+	if n := len(f.chunks); n > 0 {
+		if last := f.chunks[n-1]; len(last.buf) < cap(last.buf) {
+			return last
+		}
+	}
+
+	c := getChunk(f.chunkSize)
+	f.chunks = append(f.chunks, c)
+	return c
+}
+
+// append copies p into the tail chunk, allocating additional chunks as
+// needed. f must be locked.
+func (f *fwriter) append(p []byte) {
+	for len(p) > 0 {
+		tail := f.tail()
+		n := copy(tail.buf[len(tail.buf):cap(tail.buf)], p)
+		tail.buf = tail.buf[:len(tail.buf)+n]
+		p = p[n:]
+	}
+}
+
+// drop discards up to n bytes from the front of the buffer, returning whole
+// chunks to chunkPool once they've been fully discarded (unless they're
+// pinned by an in-flight WriteTo). f must be locked. It returns the number of
+// bytes actually dropped, which is always n as long as n <= f.bufLen().
+func (f *fwriter) drop(n int) int {
+	dropped := 0
+	for n > 0 && len(f.chunks) > 0 {
+		head := f.chunks[0]
+		remain := len(head.buf)
+		if remain <= n {
+			f.chunks = f.chunks[1:]
+			if head.pinned == 0 {
+				putChunk(head)
+			}
+			dropped += remain
+			n -= remain
+		} else {
+			head.buf = head.buf[n:]
+			dropped += n
+			n = 0
+		}
+	}
+	return dropped
+}
+
+// chunksFrom returns the chunks holding data from off to the current end of
+// the buffer. f must be locked.
+func (f *fwriter) chunksFrom(off int) []*chunk {
+	chunks, _ := f.splitFrom(off)
+	return chunks
+}
+
+// splitFrom returns the chunks holding data from off to the current end of
+// the buffer, along with the offset into the first returned chunk at which
+// off begins (the offset is only meaningful for that first chunk; every
+// chunk after it starts at 0). f must be locked.
+func (f *fwriter) splitFrom(off int) ([]*chunk, int) {
+	localOff := off - f.off
+	var out []*chunk
+	for _, c := range f.chunks {
+		if len(out) == 0 && localOff >= len(c.buf) {
+			localOff -= len(c.buf)
+			continue
+		}
+		out = append(out, c)
+	}
+	return out, localOff
+}
+
+// buffers returns the buffered data from off to the current end of the
+// buffer as a net.Buffers, ready to be handed to a single writev(2)-backed
+// WriteTo call. f must be locked.
+func (f *fwriter) buffers(off int) net.Buffers {
+	chunks, localOff := f.splitFrom(off)
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	bufs := make(net.Buffers, len(chunks))
+	for i, c := range chunks {
+		if i == 0 {
+			bufs[i] = c.buf[localOff:]
+		} else {
+			bufs[i] = c.buf
+		}
+	}
+	return bufs
+}
+
+// copyAt copies buffered data starting at off into p, spanning as many
+// chunks as necessary to fill p or drain the buffer, and returns the number
+// of bytes copied. f must be locked.
+func (f *fwriter) copyAt(off int, p []byte) int {
+	chunks, localOff := f.splitFrom(off)
+	if len(chunks) == 0 {
+		return 0
+	}
+
+	n := 0
+	for i, c := range chunks {
+		buf := c.buf
+		if i == 0 {
+			buf = buf[localOff:]
+		}
+		n += copy(p[n:], buf)
+		if n == len(p) {
+			break
+		}
+	}
+	return n
+}
+
+// wouldEvict reports whether writing blen more bytes, while enforcing Limit,
+// would discard buffered data that some existing client hasn't read yet. f
+// must be locked.
+func (f *fwriter) wouldEvict(blen int) bool {
+	if f.c.Limit == 0 || len(f.clients) == 0 {
+		return false
+	}
+
+	excess := f.bufLen() + blen - f.c.Limit
+	if excess <= 0 {
+		return false
+	}
+
+	for c := range f.clients {
+		if c.off-f.off < excess {
+			return true
+		}
+	}
+	return false
+}
+
 // Write implements the standard Write interface: it writes data to the
 // internal buffer, which will be read by all readers which were created before
 // the call (unless ReadFromStart is true). Write only returns an error when it
@@ -94,56 +383,162 @@ func (f *fwriter) Write(p []byte) (n int, err error) {
 		return blen, nil
 	}
 
-	if f.c.Limit != 0 {
-		if f.c.Limit > blen {
-			// figure out how many bytes are pushed off the end
-			invalidBytes := len(f.buf) + blen - f.c.Limit
-			if invalidBytes > 0 {
-				// chop those bytes off
-				f.buf = append(f.buf[invalidBytes:], p...)
-				// move the offset pointer forward
-				f.off += invalidBytes
-			} else {
-				// we can fit all of blen into the buffer
-				f.buf = append(f.buf, p...)
+	switch {
+	case f.c.Limit != 0 && blen > f.c.Limit && len(f.clients) > 0 && f.c.OverflowPolicy != DropOldest:
+		// this single write alone is bigger than Limit, so no amount of
+		// evicting (or, for BlockWriter, waiting for readers to drain) can
+		// keep every current reader's unread data intact.
+		f.Unlock()
+		return 0, ErrWriteWouldCauseFallBehind
+	case f.c.OverflowPolicy == BlockWriter:
+		for f.wouldEvict(blen) {
+			f.writerCond.Wait()
+			if f.closed {
+				f.Unlock()
+				return 0, io.ErrClosedPipe
 			}
-		} else {
-			// we need to invalidate ALL of f.buf since we will be replacing
-			// all of it
-			f.off += len(f.buf)
-			f.buf = p[len(p)-f.c.Limit:]
 		}
-	} else {
-		// since there is no limiting factor that doesn't panic, off will never
-		// update
-		f.buf = append(f.buf, p...)
+	}
+
+	f.append(p)
+	f.bytesWritten += int64(blen)
+
+	if f.c.Limit != 0 {
+		if excess := f.bufLen() - f.c.Limit; excess > 0 {
+			f.off += f.drop(excess)
+		}
 	}
 
 	// notify any waiting clients
-	f.update.Broadcast()
+	f.broadcast()
 
 	f.Unlock()
 	return blen, nil
 }
 
+// ReadFrom implements io.ReaderFrom: it reads directly into a freshly
+// allocated chunk and appends that chunk under the lock, avoiding the
+// double-copy io.Copy(fw, r) would otherwise make through its internal
+// scratch buffer. ReadFrom reads from r until r returns io.EOF (reported as a
+// nil error here, per the io.ReaderFrom contract) or any other error, which
+// is returned as-is.
+func (f *fwriter) ReadFrom(r io.Reader) (n int64, err error) {
+	for {
+		c := getChunk(f.chunkSize)
+		nr, rerr := r.Read(c.buf[:cap(c.buf)])
+		c.buf = c.buf[:nr]
+
+		if nr == 0 {
+			putChunk(c)
+		} else {
+			f.Lock()
+
+			switch {
+			case f.closed:
+				f.Unlock()
+				putChunk(c)
+				return n, io.ErrClosedPipe
+			case !f.c.ReadFromStart && len(f.clients) == 0:
+				// nobody to read it; discard, same as Write does
+				f.Unlock()
+				putChunk(c)
+			case f.c.Limit != 0 && nr > f.c.Limit && len(f.clients) > 0 && f.c.OverflowPolicy != DropOldest:
+				// this read alone is bigger than Limit, same as Write's
+				// equivalent guard.
+				f.Unlock()
+				putChunk(c)
+				return n, ErrWriteWouldCauseFallBehind
+			case f.c.OverflowPolicy == BlockWriter:
+				for f.wouldEvict(nr) {
+					f.writerCond.Wait()
+					if f.closed {
+						f.Unlock()
+						putChunk(c)
+						return n, io.ErrClosedPipe
+					}
+				}
+				fallthrough
+			default:
+				f.chunks = append(f.chunks, c)
+				f.bytesWritten += int64(nr)
+
+				if f.c.Limit != 0 {
+					if excess := f.bufLen() - f.c.Limit; excess > 0 {
+						f.off += f.drop(excess)
+					}
+				}
+
+				f.broadcast()
+				f.Unlock()
+			}
+
+			n += int64(nr)
+		}
+
+		if rerr != nil {
+			if rerr == io.EOF {
+				return n, nil
+			}
+			return n, rerr
+		}
+	}
+}
+
 // Write closes the FanoutWriter, causing the remaining buffer to be read by
 // currently created Readers, then respond to future read requests with io.EOF.
 func (f *fwriter) Close() error {
 	f.Lock()
 	f.closed = true
 
-	// tell the waiting clients that we have no more data
-	f.update.Broadcast()
+	// tell the waiting clients that we have no more data, and any writer
+	// blocked on backpressure that it should give up
+	f.broadcast()
+	f.writerCond.Broadcast()
 	f.Unlock()
 	return nil
 }
 
+// Stats returns a point-in-time snapshot of the writer's state.
+func (f *fwriter) Stats() Stats {
+	f.Lock()
+	s := Stats{
+		BytesWritten:  f.bytesWritten,
+		BytesBuffered: int64(f.bufLen()),
+		NumReaders:    len(f.clients),
+	}
+	f.Unlock()
+	return s
+}
+
+// Readers returns a point-in-time snapshot of every currently attached
+// reader's state.
+func (f *fwriter) Readers() []ReaderStats {
+	f.Lock()
+	stats := make([]ReaderStats, 0, len(f.clients))
+	for c := range f.clients {
+		stats = append(stats, c.stats(f))
+	}
+	f.Unlock()
+	return stats
+}
+
+// broadcast wakes every goroutine blocked in Read (via f.update) as well as
+// every goroutine blocked in ReadContext (via f.waiters). f must be locked.
+func (f *fwriter) broadcast() {
+	f.update.Broadcast()
+
+	for _, ch := range f.waiters {
+		close(ch)
+	}
+	f.waiters = make(map[*client]chan struct{})
+}
+
 // must be called while f is locked
 func (f *fwriter) updateOff() {
 	// so if we are ReadingFromStart, we let Limit during Write handle clipping
 	// old data off. Otherwise, we handle it here.
 	if !f.c.ReadFromStart {
-		offJump := len(f.buf)
+		offJump := f.bufLen()
 		for c, _ := range f.clients {
 			offDiff := c.off - f.off
 
@@ -152,9 +547,14 @@ func (f *fwriter) updateOff() {
 			}
 		}
 
-		f.buf = f.buf[offJump:]
-		f.off += offJump
+		if offJump > 0 {
+			f.off += f.drop(offJump)
+		}
 	}
+
+	// a reader just advanced (or went away), which may have freed up enough
+	// room for a writer blocked under BlockWriter to proceed
+	f.writerCond.Broadcast()
 }
 
 // Reader creates a new reader pointed at the end of the current buffer. Reader
@@ -168,12 +568,13 @@ func (f *fwriter) NewReader() (r io.ReadCloser) {
 
 	off := f.off
 	if !f.c.ReadFromStart {
-		off += len(f.buf)
+		off += f.bufLen()
 	}
 
 	c := &client{
-		fw:  f,
-		off: off,
+		fw:        f,
+		off:       off,
+		createdAt: time.Now(),
 	}
 	r = c
 
@@ -183,52 +584,181 @@ func (f *fwriter) NewReader() (r io.ReadCloser) {
 	return
 }
 
-// Read implements the standard Read interface: it reads data which is
-// available, blocking if there is no data available. If the Writer was closed,
-// Read will first return all remaining data in the buffer, then on subsequent
-// reads return an error of io.EOF.
-func (c *client) Read(p []byte) (n int, err error) {
-	c.fw.Lock()
+// read is the shared implementation behind Read and ReadContext. wait is
+// called, with f locked, whenever the reader has caught up to the writer and
+// must block for more data; it should block until more data may be available
+// and return nil, or abort the wait and return an error. In either case f
+// must be locked again by the time wait returns, mirroring the contract of
+// sync.Cond.Wait. f must be locked on entry and is locked on return.
+func (c *client) read(p []byte, wait func() error) (n int, err error) {
 	for {
 		localoff := c.off - c.fw.off
 
 		// first, lets detect whether we 'fell off the end' (due to a limit
 		// constraint). This is an error state, so we need to report it.
-		if localoff > len(c.fw.buf) || localoff < 0 {
+		if localoff > c.fw.bufLen() || localoff < 0 {
 			// if our offset minus their offset is greater then len, then we
 			// could have NEVER gotten this offset UNLESS the writer offset has
 			// surpassed us.
 			// At this point, we consider this reader to be 'closed'.
+			c.fellBehind = true
 			delete(c.fw.clients, c)
-			c.fw.Unlock()
 			return 0, ErrFellBehind
 		}
 
-		lbuf := c.fw.buf[localoff:]
 		// regardless of whether or not we have any space to read, we need to
 		// check if the writer has any more data and has closed
-		if len(lbuf) == 0 {
+		if len(c.fw.chunksFrom(c.off)) == 0 {
 			if c.fw.closed {
-				c.fw.Unlock()
 				return 0, io.EOF
-			} else {
-				// we need to wait for more data
-				c.fw.update.Wait()
-				continue
 			}
+
+			if err := wait(); err != nil {
+				delete(c.fw.clients, c)
+				c.fw.updateOff()
+				return 0, err
+			}
+			continue
 		}
 
-		ncopy := copy(p, lbuf)
+		ncopy := c.fw.copyAt(c.off, p)
 		if ncopy > 0 {
 			c.off += ncopy
+			c.bytesRead += int64(ncopy)
 			c.fw.updateOff()
 		}
 
-		c.fw.Unlock()
 		return ncopy, nil
 	}
 }
 
+// Read implements the standard Read interface: it reads data which is
+// available, blocking if there is no data available. If the Writer was closed,
+// Read will first return all remaining data in the buffer, then on subsequent
+// reads return an error of io.EOF.
+func (c *client) Read(p []byte) (n int, err error) {
+	c.fw.Lock()
+	n, err = c.read(p, func() error {
+		c.fw.update.Wait()
+		return nil
+	})
+	c.fw.Unlock()
+	return
+}
+
+// ReadContext behaves like Read, except the wait for more data may be
+// aborted by cancelling ctx. If ctx is done before the FanoutWriter has more
+// data to offer, the reader is removed from the FanoutWriter (as if Close had
+// been called on it) and ctx.Err() is returned. If ctx is already done when
+// ReadContext is called, it returns ctx.Err() at once, without reading any
+// data and without removing the reader. ReadContext does not affect any other
+// reader, nor does it close the FanoutWriter.
+func (c *client) ReadContext(ctx context.Context, p []byte) (n int, err error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	c.fw.Lock()
+	n, err = c.read(p, func() error {
+		return c.waitContext(ctx)
+	})
+	c.fw.Unlock()
+	return
+}
+
+// waitContext registers c in f.waiters and blocks until either f.broadcast
+// closes c's channel or ctx is done, whichever happens first. f must be
+// locked on entry and is locked again on return.
+func (c *client) waitContext(ctx context.Context) error {
+	woke := make(chan struct{})
+	c.fw.waiters[c] = woke
+
+	c.fw.Unlock()
+	select {
+	case <-woke:
+		c.fw.Lock()
+		return nil
+	case <-ctx.Done():
+		c.fw.Lock()
+		delete(c.fw.waiters, c)
+		return ctx.Err()
+	}
+}
+
+// WriteTo implements io.WriterTo: it writes every byte this reader has left
+// to w, using net.Buffers to hand buffered chunks to a single writev(2)-backed
+// call instead of copying through an intermediate buffer. Unlike that single
+// call, WriteTo releases the FanoutWriter's lock for the duration of each
+// write to w, so one slow destination doesn't stall the writer or other
+// readers; it only reacquires the lock to fetch the next batch of chunks and
+// to advance its cursor. WriteTo loops until the FanoutWriter is closed and
+// fully drained, at which point -- per the io.WriterTo contract -- it returns
+// a nil error rather than io.EOF.
+func (c *client) WriteTo(w io.Writer) (n int64, err error) {
+	c.fw.Lock()
+	for {
+		localoff := c.off - c.fw.off
+		if localoff > c.fw.bufLen() || localoff < 0 {
+			c.fellBehind = true
+			delete(c.fw.clients, c)
+			c.fw.Unlock()
+			return n, ErrFellBehind
+		}
+
+		chunks := c.fw.chunksFrom(c.off)
+		if len(chunks) == 0 {
+			if c.fw.closed {
+				c.fw.Unlock()
+				return n, nil
+			}
+			c.fw.update.Wait()
+			continue
+		}
+
+		bufs := c.fw.buffers(c.off)
+		for _, ch := range chunks {
+			ch.pinned++
+		}
+		c.fw.Unlock()
+
+		written, werr := bufs.WriteTo(w)
+
+		c.fw.Lock()
+		for _, ch := range chunks {
+			ch.pinned--
+		}
+		if written > 0 {
+			c.off += int(written)
+			c.bytesRead += written
+			c.fw.updateOff()
+		}
+		n += written
+
+		if werr != nil {
+			c.fw.Unlock()
+			return n, werr
+		}
+	}
+}
+
+// stats builds this client's ReaderStats. f must be locked.
+func (c *client) stats(f *fwriter) ReaderStats {
+	return ReaderStats{
+		BytesRead:  c.bytesRead,
+		Lag:        int64(f.off+f.bufLen()) - int64(c.off),
+		FellBehind: c.fellBehind,
+		CreatedAt:  c.createdAt,
+	}
+}
+
+// Stats returns a point-in-time snapshot of this reader's state.
+func (c *client) Stats() ReaderStats {
+	c.fw.Lock()
+	s := c.stats(c.fw)
+	c.fw.Unlock()
+	return s
+}
+
 // Close closes the reader. Readers should always be closed, as it allows for
 // data not yet read to be freed.
 func (c *client) Close() error {
@@ -1,6 +1,8 @@
 package fanoutwriter
 
 import (
+	"bytes"
+	"context"
 	"github.com/stretchr/testify/assert"
 	"io"
 	"runtime"
@@ -252,6 +254,301 @@ func TestLimitReaderFellBehind(t *testing.T) {
 	assert.Equal(t, ErrFellBehind, err)
 }
 
+func TestReadContextCancelledUnblocksReader(t *testing.T) {
+	fw := NewDefaultFanoutWriter()
+	defer vclose(t, fw)
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+	cr := r.(ContextReader)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	var n int
+	var err error
+	go func() {
+		rb := make([]byte, 5, 5)
+		n, err = cr.ReadContext(ctx, rb)
+		close(done)
+	}()
+
+	runtime.Gosched()
+	cancel()
+	<-done
+
+	assert.Equal(t, 0, n, "no bytes should have been read")
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestReadContextReturnsDataWithoutCancellation(t *testing.T) {
+	fw := NewDefaultFanoutWriter()
+	defer vclose(t, fw)
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+	cr := r.(ContextReader)
+
+	wb := []byte{1, 2, 3, 4, 5}
+	validateWrite(t, fw, wb)
+
+	rb := make([]byte, 5, 5)
+	n, err := cr.ReadContext(context.Background(), rb)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.ElementsMatch(t, wb, rb)
+}
+
+func TestWriteAcrossMultipleChunks(t *testing.T) {
+	fw := NewFanoutWriter(&FanoutWriterConfig{ChunkSize: 4})
+	defer vclose(t, fw)
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+
+	wb := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	validateWrite(t, fw, wb)
+
+	rb := make([]byte, 10, 10)
+	n, err := io.ReadFull(r, rb)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, n)
+	assert.ElementsMatch(t, wb, rb)
+}
+
+func TestMultiReaderOffsetPastFirstChunk(t *testing.T) {
+	fw := NewFanoutWriter(&FanoutWriterConfig{ChunkSize: 4})
+	defer vclose(t, fw)
+
+	fast := fw.NewReader()
+	defer vclose(t, fast)
+	slow := fw.NewReader()
+	defer vclose(t, slow)
+
+	wb := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	validateWrite(t, fw, wb)
+
+	// slow never reads, so it pins f.off at 0 while fast advances past the
+	// first chunk boundary -- exercising the case where a reader's offset
+	// lands in a chunk other than the first one in the buffer.
+	rb := make([]byte, 5, 5)
+	validateRead(t, fast, rb, 5)
+	assert.Equal(t, wb[:5], rb)
+
+	var out bytes.Buffer
+	vclose(t, fw)
+	n, err := fast.(io.WriterTo).WriteTo(&out)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), n)
+	assert.Equal(t, wb[5:], out.Bytes())
+}
+
+func TestReaderWriteTo(t *testing.T) {
+	fw := NewFanoutWriter(&FanoutWriterConfig{ChunkSize: 4})
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+
+	wb := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	validateWrite(t, fw, wb)
+	vclose(t, fw)
+
+	var out bytes.Buffer
+	n, err := r.(io.WriterTo).WriteTo(&out)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), n)
+	assert.Equal(t, wb, out.Bytes())
+}
+
+func TestReaderWriteToBlocksUntilWriterClosed(t *testing.T) {
+	fw := NewFanoutWriter(&FanoutWriterConfig{ChunkSize: 4})
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+
+	var out bytes.Buffer
+	done := make(chan error, 1)
+	go func() {
+		_, err := r.(io.WriterTo).WriteTo(&out)
+		done <- err
+	}()
+
+	validateWrite(t, fw, []byte{1, 2, 3})
+	validateWrite(t, fw, []byte{4, 5, 6})
+	vclose(t, fw)
+
+	assert.NoError(t, <-done)
+	assert.Equal(t, []byte{1, 2, 3, 4, 5, 6}, out.Bytes())
+}
+
+func TestWriterReadFrom(t *testing.T) {
+	fw := NewFanoutWriter(&FanoutWriterConfig{ChunkSize: 4})
+	defer vclose(t, fw)
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+
+	wb := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	n, err := fw.(io.ReaderFrom).ReadFrom(bytes.NewReader(wb))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), n)
+
+	rb := make([]byte, 10, 10)
+	_, err = io.ReadFull(r, rb)
+	assert.NoError(t, err)
+	assert.Equal(t, wb, rb)
+}
+
+func TestReadFromOversizedChunkErrors(t *testing.T) {
+	fw := NewFanoutWriter(&FanoutWriterConfig{
+		Limit: 6,
+	})
+	defer vclose(t, fw)
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+
+	// mirrors TestLimitWriteWouldCauseFallBehind: ReadFrom should apply the
+	// same ErrorOnFull guard as Write, rather than silently evicting.
+	wb := make([]byte, 7)
+	n, err := fw.(io.ReaderFrom).ReadFrom(bytes.NewReader(wb))
+	assert.Equal(t, ErrWriteWouldCauseFallBehind, err)
+	assert.Equal(t, int64(0), n)
+}
+
+func TestBlockWriterUnblocksOnRead(t *testing.T) {
+	fw := NewFanoutWriter(&FanoutWriterConfig{
+		Limit:          6,
+		OverflowPolicy: BlockWriter,
+	})
+	defer vclose(t, fw)
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+
+	wb := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	validateWrite(t, fw, wb[:6])
+
+	done := make(chan struct{})
+	go func() {
+		validateWrite(t, fw, wb[6:])
+		close(done)
+	}()
+
+	// give the writer a chance to block before we start reading
+	runtime.Gosched()
+
+	rb := make([]byte, 6, 6)
+	validateRead(t, r, rb, 6)
+
+	<-done
+}
+
+func TestBlockWriterOversizedWriteErrors(t *testing.T) {
+	fw := NewFanoutWriter(&FanoutWriterConfig{
+		Limit:          6,
+		OverflowPolicy: BlockWriter,
+	})
+	defer vclose(t, fw)
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+
+	// a single write bigger than Limit can never be satisfied, even once
+	// the reader fully drains, so it must fail fast rather than block
+	// forever.
+	wb := make([]byte, 7)
+	n, err := fw.Write(wb)
+	assert.Equal(t, ErrWriteWouldCauseFallBehind, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestBlockWriterUnblocksOnClose(t *testing.T) {
+	fw := NewFanoutWriter(&FanoutWriterConfig{
+		Limit:          6,
+		OverflowPolicy: BlockWriter,
+	})
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+
+	wb := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	validateWrite(t, fw, wb[:6])
+
+	done := make(chan struct{})
+	var err error
+	go func() {
+		_, err = fw.Write(wb[6:])
+		close(done)
+	}()
+
+	runtime.Gosched()
+	vclose(t, fw)
+	<-done
+
+	assert.Equal(t, io.ErrClosedPipe, err)
+}
+
+func TestWriterStats(t *testing.T) {
+	fw := NewDefaultFanoutWriter()
+	defer vclose(t, fw)
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+
+	wb := []byte{1, 2, 3, 4, 5}
+	validateWrite(t, fw, wb)
+
+	s := fw.Stats()
+	assert.Equal(t, int64(5), s.BytesWritten)
+	assert.Equal(t, int64(5), s.BytesBuffered)
+	assert.Equal(t, 1, s.NumReaders)
+}
+
+func TestReaderStats(t *testing.T) {
+	fw := NewDefaultFanoutWriter()
+	defer vclose(t, fw)
+
+	r := fw.NewReader()
+	defer vclose(t, r)
+
+	wb := []byte{1, 2, 3, 4, 5}
+	validateWrite(t, fw, wb)
+
+	rb := make([]byte, 3, 3)
+	validateRead(t, r, rb, 3)
+
+	sr := r.(StatsReader)
+	s := sr.Stats()
+	assert.Equal(t, int64(3), s.BytesRead)
+	assert.Equal(t, int64(2), s.Lag)
+	assert.False(t, s.FellBehind)
+
+	readers := fw.Readers()
+	assert.Len(t, readers, 1)
+	assert.Equal(t, s.BytesRead, readers[0].BytesRead)
+}
+
+func TestReaderStatsFellBehind(t *testing.T) {
+	fw := NewFanoutWriter(&FanoutWriterConfig{
+		Limit: 6,
+	})
+	defer vclose(t, fw)
+	r := fw.NewReader()
+	defer vclose(t, r)
+
+	wb := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	validateWrite(t, fw, wb[:5])
+	validateWrite(t, fw, wb[5:])
+
+	rb := make([]byte, 10, 10)
+	_, err := r.Read(rb)
+	assert.Equal(t, ErrFellBehind, err)
+
+	s := r.(StatsReader).Stats()
+	assert.True(t, s.FellBehind)
+}
+
 func TestDeferredWriteAndClose(t *testing.T) {
 	fw := NewDefaultFanoutWriter()
 